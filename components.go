@@ -0,0 +1,230 @@
+package main
+
+import (
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// --- Component / Custodian Architecture ---
+// Mirrors the provider-composition pattern from moth: each long-lived
+// subsystem is a Component driven by a shared custodian ticker instead of
+// being woven directly into the Bubble Tea Update loop. Components publish
+// their results on a channel; the TUI just subscribes to snapshots.
+
+const (
+	CustodianTickInterval     = 1 * time.Second
+	DaemonHealthCheckInterval = 20 * time.Second
+	NotesRescanInterval       = 5 * time.Second
+	ConfigSaveDebounce        = 2 * time.Second
+)
+
+// Component is a subsystem the custodian drives on every tick.
+type Component interface {
+	Update()
+}
+
+// custodian ticks every interval and drives each component's Update in turn.
+// It never returns; callers launch it in its own goroutine.
+func custodian(interval time.Duration, components ...Component) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, c := range components {
+			c.Update()
+		}
+	}
+}
+
+// cloneProjects returns a shallow copy of projects, safe to hand to a
+// component that will read it from another goroutine - callers like
+// ConfigComponent.Save and NotesComponent.SetProjects are otherwise handed
+// the Bubble Tea model's live backing array, which the Update loop keeps
+// mutating in place (sort.Slice, slices.Delete, field writes) concurrently
+// with the custodian goroutine reading it.
+func cloneProjects(projects []Project) []Project {
+	clone := make([]Project, len(projects))
+	copy(clone, projects)
+	return clone
+}
+
+// publishLatest sends v on ch without blocking, dropping whatever stale
+// value was there so a slow consumer always sees the most recent snapshot.
+func publishLatest[T any](ch chan T, v T) {
+	select {
+	case ch <- v:
+	default:
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- v
+	}
+}
+
+// --- DaemonComponent ---
+// Owns the emacs --daemon process lifecycle and health-checks it on an
+// interval via `emacsclient --eval "t"`, publishing the result.
+type DaemonComponent struct {
+	checkInterval time.Duration
+	Snapshots     chan bool
+
+	mu        sync.Mutex
+	started   bool
+	lastCheck time.Time
+}
+
+func NewDaemonComponent(checkInterval time.Duration) *DaemonComponent {
+	return &DaemonComponent{
+		checkInterval: checkInterval,
+		Snapshots:     make(chan bool, 1),
+	}
+}
+
+// Start spins up `emacs --daemon` once, in the background, and publishes the
+// first health snapshot once it reports ready.
+func (d *DaemonComponent) Start() {
+	d.mu.Lock()
+	if d.started {
+		d.mu.Unlock()
+		return
+	}
+	d.started = true
+	d.mu.Unlock()
+
+	go func() {
+		ch := make(chan string)
+		go runEmacsDaemon(ch)
+		if res := <-ch; res == SuccessMsg {
+			publishLatest(d.Snapshots, true)
+		}
+	}()
+}
+
+// Update is driven by the custodian and re-checks daemon health once
+// checkInterval has elapsed since the last check.
+func (d *DaemonComponent) Update() {
+	d.mu.Lock()
+	due := time.Since(d.lastCheck) >= d.checkInterval
+	if due {
+		d.lastCheck = time.Now()
+	}
+	d.mu.Unlock()
+	if !due {
+		return
+	}
+	publishLatest(d.Snapshots, d.healthy())
+}
+
+func (d *DaemonComponent) healthy() bool {
+	return exec.Command("emacsclient", "--eval", "t").Run() == nil
+}
+
+// --- NotesComponent ---
+// Rescans each project's directory for YYYY-MM-DD.org files once
+// rescanInterval has elapsed since the last scan and republishes LastFileCreated/PreviousFileCreated from what's actually
+// on disk, rather than trusting stale state carried over in projects.json.
+type NotesComponent struct {
+	rescanInterval time.Duration
+	Snapshots      chan []Project
+
+	mu       sync.Mutex
+	projects []Project
+	lastScan time.Time
+}
+
+func NewNotesComponent(initial []Project) *NotesComponent {
+	return &NotesComponent{
+		rescanInterval: NotesRescanInterval,
+		Snapshots:      make(chan []Project, 1),
+		projects:       cloneProjects(initial),
+	}
+}
+
+// SetProjects replaces the set of projects to track, e.g. after the user
+// adds or deletes one.
+func (n *NotesComponent) SetProjects(projects []Project) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.projects = cloneProjects(projects)
+}
+
+func (n *NotesComponent) Update() {
+	n.mu.Lock()
+	due := time.Since(n.lastScan) >= n.rescanInterval
+	if due {
+		n.lastScan = time.Now()
+	}
+	projects := make([]Project, len(n.projects))
+	copy(projects, n.projects)
+	n.mu.Unlock()
+	if !due {
+		return
+	}
+
+	changed := false
+	for i := range projects {
+		notes := InventoryForProject(&projects[i])
+		if len(notes) == 0 {
+			continue
+		}
+		last := notes[0].Path
+		previous := ""
+		if len(notes) > 1 {
+			previous = notes[1].Path
+		}
+		if projects[i].LastFileCreated != last || projects[i].PreviousFileCreated != previous {
+			projects[i].LastFileCreated = last
+			projects[i].PreviousFileCreated = previous
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+
+	n.mu.Lock()
+	n.projects = projects
+	n.mu.Unlock()
+
+	publishLatest(n.Snapshots, projects)
+}
+
+// --- ConfigComponent ---
+// Coalesces rapid saveConfig calls (add/delete/star/select all firing in
+// quick succession) into a single debounced write.
+type ConfigComponent struct {
+	debounce time.Duration
+
+	mu      sync.Mutex
+	pending []Project
+	dirty   bool
+	due     time.Time
+}
+
+func NewConfigComponent(debounce time.Duration) *ConfigComponent {
+	return &ConfigComponent{debounce: debounce}
+}
+
+// Save schedules projects to be persisted once debounce has elapsed without
+// another Save call.
+func (c *ConfigComponent) Save(projects []Project) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending = cloneProjects(projects)
+	c.dirty = true
+	c.due = time.Now().Add(c.debounce)
+}
+
+func (c *ConfigComponent) Update() {
+	c.mu.Lock()
+	if !c.dirty || time.Now().Before(c.due) {
+		c.mu.Unlock()
+		return
+	}
+	projects := c.pending
+	c.dirty = false
+	c.mu.Unlock()
+
+	saveConfig(projects)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestNotesComponentPreservesFieldsSetOutOfBand reproduces the bug where a
+// field mutated directly on the model's project list (e.g. Starred via the
+// "s" key) got silently reverted by the next NotesComponent snapshot, because
+// NotesComponent kept rescanning its own, out-of-sync copy. Callers are
+// expected to push every such mutation through SetProjects immediately, as
+// the "s"/"enter" key handlers and createDailyNote/applyRemigration/
+// declineRemigration now do.
+func TestNotesComponentPreservesFieldsSetOutOfBand(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "2026-07-25.org"), []byte("x"), 0644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	n := NewNotesComponent([]Project{{Name: "work", Path: dir}})
+
+	projects := []Project{{Name: "work", Path: dir, Starred: true}}
+	n.SetProjects(projects)
+
+	n.Update()
+
+	select {
+	case snapshot := <-n.Snapshots:
+		if len(snapshot) != 1 || !snapshot[0].Starred {
+			t.Errorf("snapshot after Update() = %+v, want Starred=true preserved", snapshot)
+		}
+	default:
+		t.Fatal("expected Update() to publish a snapshot once a rescan detected new notes")
+	}
+}
+
+// TestNotesComponentSetProjectsDoesNotAliasCaller guards the data-race fix:
+// mutating the slice passed to SetProjects afterwards must not affect the
+// component's internal copy.
+func TestNotesComponentSetProjectsDoesNotAliasCaller(t *testing.T) {
+	n := NewNotesComponent(nil)
+
+	projects := []Project{{Name: "work", Path: "/tmp/work"}}
+	n.SetProjects(projects)
+	projects[0].Starred = true
+
+	n.mu.Lock()
+	got := n.projects[0].Starred
+	n.mu.Unlock()
+
+	if got {
+		t.Error("mutating the caller's slice after SetProjects changed the component's internal copy")
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import "testing"
+
+func TestParseConfigBytes(t *testing.T) {
+	t.Run("empty data returns zero value", func(t *testing.T) {
+		cf := parseConfigBytes(nil)
+		if cf.Projects != nil || cf.Settings != (Settings{}) {
+			t.Errorf("parseConfigBytes(nil) = %+v, want zero value", cf)
+		}
+	})
+
+	t.Run("current format with settings", func(t *testing.T) {
+		data := []byte(`{"Projects":[{"Name":"work","Path":"/home/u/work"}],"Settings":{"log_retention_days":14}}`)
+		cf := parseConfigBytes(data)
+		if len(cf.Projects) != 1 || cf.Projects[0].Name != "work" {
+			t.Fatalf("parseConfigBytes() Projects = %+v, want one project named work", cf.Projects)
+		}
+		if cf.Settings.LogRetentionDays != 14 {
+			t.Errorf("parseConfigBytes() LogRetentionDays = %d, want 14", cf.Settings.LogRetentionDays)
+		}
+	})
+
+	t.Run("legacy bare-array format falls back", func(t *testing.T) {
+		data := []byte(`[{"Name":"work","Path":"/home/u/work"},{"Name":"home","Path":"/home/u/home"}]`)
+		cf := parseConfigBytes(data)
+		if len(cf.Projects) != 2 {
+			t.Fatalf("parseConfigBytes() Projects = %+v, want 2 legacy projects", cf.Projects)
+		}
+		if cf.Projects[0].Name != "work" || cf.Projects[1].Name != "home" {
+			t.Errorf("parseConfigBytes() Projects = %+v, want work then home", cf.Projects)
+		}
+		if cf.Settings.LogRetentionDays != 0 {
+			t.Errorf("parseConfigBytes() legacy format should have zero-value Settings, got %+v", cf.Settings)
+		}
+	})
+}
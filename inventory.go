@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var dailyNoteName = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}\.org$`)
+
+// DailyNote is one dated org file discovered in a project directory.
+type DailyNote struct {
+	Date time.Time
+	Path string
+}
+
+// InventoryProject walks path - daily notes live flat in the project root,
+// so this isn't recursive - and returns every YYYY-MM-DD.org file found,
+// sorted newest first.
+func InventoryProject(path string) ([]DailyNote, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var notes []DailyNote
+	for _, e := range entries {
+		if e.IsDir() || !dailyNoteName.MatchString(e.Name()) {
+			continue
+		}
+		dateStr := strings.TrimSuffix(e.Name(), FileExtension)
+		day, err := time.ParseInLocation(DateFormat, dateStr, time.Local)
+		if err != nil {
+			continue
+		}
+		notes = append(notes, DailyNote{Date: day, Path: filepath.Join(path, e.Name())})
+	}
+
+	sort.Slice(notes, func(i, j int) bool { return notes[i].Date.After(notes[j].Date) })
+	return notes, nil
+}
+
+// InventoryForProject returns proj's inventory, rescanning proj.Path only if
+// it hasn't been scanned yet or has changed since the last scan - cheap
+// enough to call on every keypress even for directories with hundreds of
+// notes.
+func InventoryForProject(proj *Project) []DailyNote {
+	info, err := os.Stat(proj.Path)
+	if err != nil {
+		return nil
+	}
+	if !proj.inventoryScannedAt.IsZero() && !info.ModTime().After(proj.inventoryScannedAt) {
+		return proj.inventory
+	}
+
+	notes, err := InventoryProject(proj.Path)
+	if err != nil {
+		return proj.inventory
+	}
+	proj.inventory = notes
+	proj.inventoryScannedAt = time.Now()
+	return notes
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInventoryProject(t *testing.T) {
+	dir := t.TempDir()
+	names := []string{
+		"2026-07-24.org",
+		"2026-07-25.org",
+		"2026-07-26.org",
+		"not-a-note.org",
+		"2026-13-40.org", // looks like a daily note, doesn't parse as a date
+	}
+	for _, n := range names {
+		if err := os.WriteFile(filepath.Join(dir, n), []byte("x"), 0644); err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "2026-07-27.org"), 0755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	notes, err := InventoryProject(dir)
+	if err != nil {
+		t.Fatalf("InventoryProject: %v", err)
+	}
+
+	if len(notes) != 3 {
+		t.Fatalf("InventoryProject() returned %d notes, want 3: %+v", len(notes), notes)
+	}
+	wantOrder := []string{"2026-07-26.org", "2026-07-25.org", "2026-07-24.org"}
+	for i, want := range wantOrder {
+		if filepath.Base(notes[i].Path) != want {
+			t.Errorf("notes[%d] = %s, want %s (expected newest-first order)", i, filepath.Base(notes[i].Path), want)
+		}
+	}
+}
+
+func TestInventoryProjectMissingDir(t *testing.T) {
+	if _, err := InventoryProject(filepath.Join(t.TempDir(), "does-not-exist")); err == nil {
+		t.Error("InventoryProject on a missing directory should error, got nil")
+	}
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// --- Logging Subsystem ---
+// Replaces the old hardcoded C:\Users\Ramand\Desktop\... path with a proper
+// per-user log location plus daily rotation, so the binary doesn't carry
+// machine-specific paths.
+
+const (
+	LogFileName         = "goday.log"
+	DefaultLogRetention = 7 // days, overridable via projects.json settings
+)
+
+// Logger is the minimal surface the rest of the app writes diagnostics through.
+// Kept narrow on purpose so a no-op/test implementation is trivial to provide.
+type Logger interface {
+	Printf(format string, args ...any)
+	Println(args ...any)
+	Close() error
+}
+
+// fileLogger is the default Logger: a single active file protected by a
+// mutex, rotated at local midnight by a background goroutine.
+type fileLogger struct {
+	mu            sync.Mutex
+	dir           string
+	file          *os.File
+	day           string // YYYY-MM-DD of the currently open file, used to detect midnight
+	retentionDays int
+	done          chan struct{}
+}
+
+// NewFileLogger opens (or creates) the active log file under the standard
+// state directory and starts the midnight-rotation goroutine.
+func NewFileLogger(retentionDays int) (*fileLogger, error) {
+	if retentionDays <= 0 {
+		retentionDays = DefaultLogRetention
+	}
+	dir, err := logDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	l := &fileLogger{
+		dir:           dir,
+		retentionDays: retentionDays,
+		done:          make(chan struct{}),
+	}
+	if err := l.openCurrent(); err != nil {
+		return nil, err
+	}
+	go l.rotateLoop()
+	return l, nil
+}
+
+// logDir resolves $XDG_STATE_HOME/goday on POSIX, falling back to
+// %LOCALAPPDATA%\goday on Windows (or ~/.local/state/goday if neither is set).
+func logDir() (string, error) {
+	if xdg := os.Getenv("XDG_STATE_HOME"); xdg != "" {
+		return filepath.Join(xdg, ConfigDirName), nil
+	}
+	if local := os.Getenv("LOCALAPPDATA"); local != "" {
+		return filepath.Join(local, ConfigDirName), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "state", ConfigDirName), nil
+}
+
+func (l *fileLogger) activePath() string {
+	return filepath.Join(l.dir, LogFileName)
+}
+
+func (l *fileLogger) openCurrent() error {
+	f, err := os.OpenFile(l.activePath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	l.file = f
+	l.day = time.Now().Format(DateFormat)
+	return nil
+}
+
+func (l *fileLogger) Printf(format string, args ...any) {
+	l.write(fmt.Sprintf(format, args...))
+}
+
+func (l *fileLogger) Println(args ...any) {
+	l.write(fmt.Sprintln(args...))
+}
+
+func (l *fileLogger) write(msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return
+	}
+	stamp := time.Now().Format("2006-01-02 15:04:05")
+	if len(msg) == 0 || msg[len(msg)-1] != '\n' {
+		msg += "\n"
+	}
+	fmt.Fprintf(l.file, "[%s] %s", stamp, msg)
+}
+
+func (l *fileLogger) Close() error {
+	close(l.done)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// rotateLoop wakes up at local midnight, rotates the active file, and
+// reschedules itself for the following midnight.
+func (l *fileLogger) rotateLoop() {
+	for {
+		next := nextMidnight(time.Now())
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+			if err := l.rotate(); err != nil {
+				l.write(fmt.Sprintf("log rotation failed: %v", err))
+			}
+		case <-l.done:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func nextMidnight(from time.Time) time.Time {
+	y, m, d := from.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, from.Location())
+}
+
+// rotate compresses the active file into goday-YYYY-MM-DD.log.gz for the day
+// that just ended and removes the uncompressed original, reopens a fresh active handle, and prunes anything older than
+// retentionDays.
+func (l *fileLogger) rotate() error {
+	l.mu.Lock()
+	rotatedDay := l.day
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+	l.mu.Unlock()
+
+	gzPath := filepath.Join(l.dir, fmt.Sprintf("goday-%s.log.gz", rotatedDay))
+	if err := gzipAndRemove(l.activePath(), gzPath); err != nil {
+		// Still reopen the active file below so logging keeps working.
+		l.mu.Lock()
+		_ = l.openCurrent()
+		l.mu.Unlock()
+		return err
+	}
+
+	l.mu.Lock()
+	err := l.openCurrent()
+	l.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return l.pruneOld()
+}
+
+func gzipAndRemove(srcPath, dstPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(dstPath)
+	if err != nil {
+		return err
+	}
+	gw := gzip.NewWriter(dst)
+	if _, err := io.Copy(gw, src); err != nil {
+		gw.Close()
+		dst.Close()
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	src.Close()
+	return os.Remove(srcPath)
+}
+
+func (l *fileLogger) pruneOld() error {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return err
+	}
+	cutoff := time.Now().AddDate(0, 0, -l.retentionDays)
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, "goday-") || !strings.HasSuffix(name, ".log.gz") {
+			continue
+		}
+		dayStr := strings.TrimSuffix(strings.TrimPrefix(name, "goday-"), ".log.gz")
+		day, err := time.ParseInLocation(DateFormat, dayStr, time.Local)
+		if err != nil || day.After(cutoff) {
+			continue
+		}
+		_ = os.Remove(filepath.Join(l.dir, e.Name()))
+	}
+	return nil
+}
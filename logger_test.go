@@ -0,0 +1,90 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNextMidnight(t *testing.T) {
+	loc := time.UTC
+	cases := []struct {
+		name string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "middle of the day",
+			from: time.Date(2026, 7, 26, 14, 30, 0, 0, loc),
+			want: time.Date(2026, 7, 27, 0, 0, 0, 0, loc),
+		},
+		{
+			name: "right after midnight",
+			from: time.Date(2026, 7, 26, 0, 0, 1, 0, loc),
+			want: time.Date(2026, 7, 27, 0, 0, 0, 0, loc),
+		},
+		{
+			name: "exactly midnight still rolls to the next day",
+			from: time.Date(2026, 7, 26, 0, 0, 0, 0, loc),
+			want: time.Date(2026, 7, 27, 0, 0, 0, 0, loc),
+		},
+		{
+			name: "month boundary",
+			from: time.Date(2026, 7, 31, 23, 59, 59, 0, loc),
+			want: time.Date(2026, 8, 1, 0, 0, 0, 0, loc),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := nextMidnight(tc.from)
+			if !got.Equal(tc.want) {
+				t.Errorf("nextMidnight(%v) = %v, want %v", tc.from, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPruneOld(t *testing.T) {
+	dir := t.TempDir()
+	today := time.Now()
+
+	keep := "goday-" + today.AddDate(0, 0, -1).Format(DateFormat) + ".log.gz"
+	dropOld := "goday-" + today.AddDate(0, 0, -10).Format(DateFormat) + ".log.gz"
+	dropWrongExt := "goday-" + today.AddDate(0, 0, -10).Format(DateFormat) + ".log"
+	dropUnrelated := "notes.org"
+
+	for _, name := range []string{keep, dropOld, dropWrongExt, dropUnrelated} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	l := &fileLogger{dir: dir, retentionDays: 7}
+	if err := l.pruneOld(); err != nil {
+		t.Fatalf("pruneOld: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	remaining := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		remaining[e.Name()] = true
+	}
+
+	if !remaining[keep] {
+		t.Errorf("expected %q to survive pruning, entries: %v", keep, remaining)
+	}
+	if remaining[dropOld] {
+		t.Errorf("expected %q to be pruned as older than retention", dropOld)
+	}
+	if !remaining[dropWrongExt] {
+		t.Errorf("expected %q (wrong extension) to be left alone", dropWrongExt)
+	}
+	if !remaining[dropUnrelated] {
+		t.Errorf("expected %q (unrelated file) to be left alone", dropUnrelated)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"os/user"
@@ -90,21 +91,78 @@ type Project struct {
 	LastOpened          time.Time `json:"last_opened"`
 	LastFileCreated     string    `json:"last_file_created"`
 	PreviousFileCreated string    `json:"previous_file_created"`
+	// SourceFile is the note LastFileCreated's "For tomorrow" section was
+	// migrated from. Recorded once when LastFileCreated is generated so later
+	// re-opens on the same day can re-check that same file for edits, instead
+	// of re-deriving "yesterday" from the directory inventory - which would
+	// just point back at LastFileCreated itself once it exists.
+	SourceFile string `json:"source_file"`
+	// SourceMtime is the mtime SourceFile had last time we migrated it, or
+	// last time the user declined re-migrating it. Used to detect whether
+	// that section has since been edited and needs re-migrating.
+	SourceMtime time.Time `json:"source_mtime"`
+
+	// inventory caches InventoryProject(Path) so the TUI doesn't re-walk
+	// directories with hundreds of notes on every keypress. Not persisted;
+	// see InventoryForProject for the staleness check.
+	inventory          []DailyNote
+	inventoryScannedAt time.Time
 }
 
+// Settings holds app-wide options that live alongside the project list in
+// projects.json, as opposed to per-project state.
+type Settings struct {
+	LogRetentionDays int `json:"log_retention_days"`
+}
+
+// configFile is the on-disk shape of projects.json.
+type configFile struct {
+	Projects []Project `json:"projects"`
+	Settings Settings  `json:"settings"`
+}
+
+// appLogger is the process-wide diagnostics sink. Replaced in main() once
+// the settings are loaded; anything logged before that (e.g. a failure to
+// read projects.json for those settings) goes to the no-op logger.
+var appLogger Logger = noopLogger{}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...any) {}
+func (noopLogger) Println(...any)        {}
+func (noopLogger) Close() error          { return nil }
+
 // --- Bubble Tea Model ---
 type model struct {
-	projects      []Project
-	cursor        int
-	addingNew     bool
-	textInput     textinput.Model
-	selectedPath  string
-	width, height int
-	daemonSpunUp  bool
+	projects         []Project
+	cursor           int
+	addingNew        bool
+	textInput        textinput.Model
+	selectedPath     string
+	width, height    int
+	daemonSpunUp     bool
+	pendingRemigrate *RemigrateRequest
+
+	daemon *DaemonComponent
+	notes  *NotesComponent
+	config *ConfigComponent
 }
 
 type DaemonReadyMsg struct{}
-type StartDaemonMsg struct{}
+
+// DaemonHealthMsg carries the latest health snapshot from DaemonComponent.
+type DaemonHealthMsg struct{ Healthy bool }
+
+// NotesSnapshotMsg carries the latest on-disk project state from
+// NotesComponent.
+type NotesSnapshotMsg struct{ Projects []Project }
+
+// RemigrateConfirmMsg is emitted when the "For tomorrow" section of the
+// previous note was edited after today's note was generated from it, asking
+// the user whether to pull the new items in.
+type RemigrateConfirmMsg struct {
+	Request *RemigrateRequest
+}
 
 func initialModel() model {
 	ti := textinput.New()
@@ -115,17 +173,38 @@ func initialModel() model {
 	// Style the input prompt
 	ti.PromptStyle = lipgloss.NewStyle().Foreground(highlight)
 
+	projects := loadConfig()
+
 	return model{
-		projects:  loadConfig(),
+		projects:  projects,
 		textInput: ti,
 		cursor:    0,
+		daemon:    NewDaemonComponent(DaemonHealthCheckInterval),
+		notes:     NewNotesComponent(projects),
+		config:    NewConfigComponent(ConfigSaveDebounce),
 	}
 }
 
 func (m model) Init() tea.Cmd {
 	lipgloss.SetHasDarkBackground(false)
+	m.daemon.Start()
+	go custodian(CustodianTickInterval, m.daemon, m.notes, m.config)
+	return tea.Batch(listenDaemonHealth(m.daemon), listenNotesSnapshot(m.notes))
+}
+
+// listenDaemonHealth returns a Cmd that blocks for the next DaemonComponent
+// snapshot; the Update loop re-issues it after each message so the model
+// keeps subscribing for as long as the program runs.
+func listenDaemonHealth(d *DaemonComponent) tea.Cmd {
+	return func() tea.Msg {
+		return DaemonHealthMsg{Healthy: <-d.Snapshots}
+	}
+}
+
+// listenNotesSnapshot mirrors listenDaemonHealth for NotesComponent.
+func listenNotesSnapshot(n *NotesComponent) tea.Cmd {
 	return func() tea.Msg {
-		return StartDaemonMsg{}
+		return NotesSnapshotMsg{Projects: <-n.Snapshots}
 	}
 }
 
@@ -135,22 +214,20 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 
-	case StartDaemonMsg:
-		return m, func() tea.Msg {
-			fmt.Println("xd")
-			ch := make(chan string)
-			go func() {
-				runEmacsDaemon(ch)
-			}()
-			emacsRunResult := <-ch
-			if emacsRunResult == SuccessMsg {
-				return DaemonReadyMsg{}
-			}
-			return nil
-		}
+	case DaemonHealthMsg:
+		m.daemonSpunUp = msg.Healthy
+		return m, listenDaemonHealth(m.daemon)
+
+	case NotesSnapshotMsg:
+		m.projects = msg.Projects
+		m.sortProjects()
+		return m, listenNotesSnapshot(m.notes)
 
 	case DaemonReadyMsg:
-		m.daemonSpunUp = true
+		return m, nil
+
+	case RemigrateConfirmMsg:
+		m.pendingRemigrate = msg.Request
 		return m, nil
 
 	case tea.WindowSizeMsg:
@@ -158,6 +235,28 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 
 	case tea.KeyMsg:
+		// Confirmation Mode - stale "For tomorrow" section needs a decision
+		if m.pendingRemigrate != nil {
+			req := m.pendingRemigrate
+			switch msg.String() {
+			case "y":
+				m.pendingRemigrate = nil
+				return m, func() tea.Msg {
+					applyRemigration(req, &m)
+					finishDailyWorkflow(req.TodayPath, req.PreviousPath)
+					return DaemonReadyMsg{}
+				}
+			case "n", "esc":
+				m.pendingRemigrate = nil
+				return m, func() tea.Msg {
+					declineRemigration(req, &m)
+					finishDailyWorkflow(req.TodayPath, req.PreviousPath)
+					return DaemonReadyMsg{}
+				}
+			}
+			return m, nil
+		}
+
 		// Input Mode
 		if m.addingNew {
 			switch msg.Type {
@@ -201,35 +300,32 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.cursor >= len(m.projects) && m.cursor > 0 {
 					m.cursor--
 				}
-				saveConfig(m.projects)
+				m.notes.SetProjects(m.projects)
+				m.config.Save(m.projects)
 			}
 		case "s":
 			if len(m.projects) > 0 {
 				m.projects[m.cursor].Starred = !m.projects[m.cursor].Starred
 				m.sortProjects()
-				saveConfig(m.projects)
+				m.notes.SetProjects(m.projects)
+				m.config.Save(m.projects)
 			}
 		case "enter":
 			if len(m.projects) > 0 {
 				m.projects[m.cursor].LastOpened = time.Now()
 				m.selectedPath = m.projects[m.cursor].Path
+				// Schedule the (debounced) save now instead of waiting for Emacs to close, which is
+				// what the old inline call after this branch actually amounted to.
+				m.notes.SetProjects(m.projects)
+				m.config.Save(m.projects)
 				if m.selectedPath != "" {
-					//Could do save outside and sync them but not needed prolly
-					//Honestly this is kinda problematic as it will only save config once emacs is closed
 					return m, func() tea.Msg {
-						runDailyWorkflow(m.selectedPath, &m)
-						return DaemonReadyMsg{}
+						return runDailyWorkflow(m.selectedPath, &m)
 					}
 				}
 				m.sortProjects()
-				saveConfig(m.projects)
 				// return m, tea.Quit
 			}
-		//For testing functions
-		case "t":
-			return m, func() tea.Msg {
-				return StartDaemonMsg{}
-			}
 		}
 	}
 	return m, cmd
@@ -242,7 +338,18 @@ func (m model) View() string {
 	// 1. Title
 	s += titleStyle.Render("GODAY") + "\n\n"
 
-	// 2. Input Mode
+	// 2. Remigration Confirmation Mode
+	if m.pendingRemigrate != nil {
+		s += "Yesterday's \"For tomorrow\" section changed since today's note was generated.\n"
+		s += "New items to migrate in:\n\n"
+		for _, item := range m.pendingRemigrate.NewItems {
+			s += pathStyle.Render(item) + "\n"
+		}
+		s += "\n" + helpStyle.Render("(y to migrate them in • n to skip)")
+		return docStyle.Render(s)
+	}
+
+	// 3. Input Mode
 	if m.addingNew {
 		s += "Enter path to new project:\n"
 		s += m.textInput.View() + "\n\n"
@@ -250,14 +357,14 @@ func (m model) View() string {
 		return docStyle.Render(s)
 	}
 
-	// 3. Empty State
+	// 4. Empty State
 	if len(m.projects) == 0 {
 		s += lipgloss.NewStyle().Foreground(subtle).Render("No projects found.") + "\n\n"
 		s += helpStyle.Render("Press 'a' to add a project.")
 		return docStyle.Render(s)
 	}
 
-	// 4. List Projects
+	// 5. List Projects
 	for i, p := range m.projects {
 		// Determine icons and content
 		starIcon := " "
@@ -291,7 +398,7 @@ func (m model) View() string {
 
 	s += "\n\n"
 
-	// 5. Daemon status
+	// 6. Daemon status
 	daemonInfo := normalStyle.Render("DAEMON status: ")
 	//This may be a bit more taxing on perfomancec
 	daemonStatus := failureStyle.Render("OFFLINE")
@@ -300,7 +407,7 @@ func (m model) View() string {
 	}
 	s += lipgloss.JoinHorizontal(lipgloss.Left, daemonInfo, daemonStatus)
 
-	// 6. Help Footer
+	// 7. Help Footer
 	helpStr := "a: add • s: star • d: delete • enter: open • q: quit"
 	s += helpStyle.Render(helpStr)
 
@@ -308,13 +415,18 @@ func (m model) View() string {
 }
 
 func main() {
+	if l, err := NewFileLogger(loadSettings().LogRetentionDays); err == nil {
+		appLogger = l
+		defer l.Close()
+	}
+
 	//Run deamon in the background
 
 	p := tea.NewProgram(initialModel(), tea.WithAltScreen())
 
 	_, err := p.Run()
 	if err != nil {
-		fmt.Printf("Error: %v", err)
+		appLogger.Printf("Error: %v", err)
 		os.Exit(1)
 	}
 }
@@ -348,7 +460,8 @@ func (m *model) addProject(pathStr string) {
 	}
 	m.projects = append(m.projects, newProj)
 	m.sortProjects()
-	saveConfig(m.projects)
+	m.notes.SetProjects(m.projects)
+	m.config.Save(m.projects)
 }
 
 // --- Persistence ---
@@ -361,14 +474,58 @@ func getConfigPath() string {
 	return filepath.Join(configDir, ConfigFileName)
 }
 
-func loadConfig() []Project {
+// parseConfigBytes decodes projects.json, falling back to the pre-Settings
+// format where the file was a bare project array.
+func parseConfigBytes(data []byte) configFile {
+	var cf configFile
+	if len(data) == 0 {
+		return cf
+	}
+	if err := json.Unmarshal(data, &cf); err != nil || (cf.Projects == nil && cf.Settings == (Settings{})) {
+		var projects []Project
+		if err := json.Unmarshal(data, &projects); err == nil {
+			cf.Projects = projects
+		}
+	}
+	return cf
+}
+
+// withConfigLock opens projects.json for read/write, takes an exclusive lock
+// on it for the duration of fn, and releases it on return - so two
+// concurrent goday invocations can't interleave a load and a save.
+func withConfigLock(path string, fn func(*os.File) error) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := lockFile(f); err != nil {
+		return err
+	}
+	defer unlockFile(f)
+	return fn(f)
+}
+
+func loadConfigFile() configFile {
 	path := getConfigPath()
-	file, err := os.ReadFile(path)
+	var cf configFile
+	err := withConfigLock(path, func(f *os.File) error {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		cf = parseConfigBytes(data)
+		return nil
+	})
 	if err != nil {
-		return []Project{}
+		appLogger.Printf("failed to load config: %v", err)
 	}
-	var c []Project
-	json.Unmarshal(file, &c)
+	return cf
+}
+
+func loadConfig() []Project {
+	cf := loadConfigFile()
+	c := cf.Projects
 	sort.Slice(c, func(i, j int) bool {
 		if c[i].Starred != c[j].Starred {
 			return c[i].Starred
@@ -378,61 +535,174 @@ func loadConfig() []Project {
 	return c
 }
 
+func loadSettings() Settings {
+	return loadConfigFile().Settings
+}
+
+// saveConfig persists the project list, preserving whatever settings are
+// already on disk since callers only ever have the project slice in hand.
+// The write itself is a durable temp-file + fsync + rename dance, all done
+// while holding the same lock loadConfigFile takes.
 func saveConfig(c []Project) {
 	path := getConfigPath()
-	data, _ := json.MarshalIndent(c, "", "  ")
-	_ = os.WriteFile(path, data, 0644)
+	dir := filepath.Dir(path)
+
+	err := withConfigLock(path, func(f *os.File) error {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		existing := parseConfigBytes(data)
+
+		cf := configFile{Projects: c, Settings: existing.Settings}
+		out, err := json.MarshalIndent(cf, "", "  ")
+		if err != nil {
+			return err
+		}
+		return atomicWriteFile(dir, path, out)
+	})
+	if err != nil {
+		appLogger.Printf("failed to save config: %v", err)
+	}
+}
+
+// atomicWriteFile writes data to a temp file beside path, fsyncs it, renames
+// it over path, then fsyncs the containing directory so the rename survives
+// a crash on POSIX (syncDir is a no-op on Windows).
+func atomicWriteFile(dir, path string, data []byte) error {
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp.*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once the rename below succeeds
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	return syncDir(dir)
 }
 
 // --- File Operations ---
-func runDailyWorkflow(projectPath string, m *model) {
+func runDailyWorkflow(projectPath string, m *model) tea.Msg {
 	//Is this notation better or is the seperate if and os.stat better?
 	//Overall this seems to be a good way to check for errors. So Function does a check -> Do something if it exists (err == nil)
 	// OLD -> if _, err := os.Stat(projectPath); os.IsNotExist(err) {
 	if _, err := os.Stat(projectPath); errors.Is(err, os.ErrNotExist) {
-		fmt.Printf("Project path does not exist: %s\n", projectPath)
-		return
+		appLogger.Printf("Project path does not exist: %s", projectPath)
+		return DaemonReadyMsg{}
 	}
 	now := time.Now()
 	todayFilename := now.Format(DateFormat) + FileExtension
-	//This is taking filename from our "standard name"
-	//I'd like to make it so that It will actually either know which file is the last one or
-	//Use a sorting algo by the date to have them sorted and take the first one
-	//If I am already storing the starred projects I may as well store there the last file created
+	todayPath := filepath.Join(projectPath, todayFilename)
+
+	proj := &m.projects[m.cursor]
 	var yesterdayPath string
-	if len(m.projects[m.cursor].LastFileCreated) > 0 {
-		yesterdayPath = m.projects[m.cursor].LastFileCreated
-	} else {
-		yesterdayFilename := now.AddDate(0, 0, -1).Format(DateFormat) + FileExtension
-		yesterdayPath = filepath.Join(projectPath, yesterdayFilename)
+	if proj.LastFileCreated == todayPath && proj.SourceFile != "" {
+		// Today's note was already generated earlier today - reuse the
+		// source recorded then. The inventory would just point back at
+		// today's own file by now, which is never what we want to diff
+		// against for staleness.
+		yesterdayPath = proj.SourceFile
+	} else if inventory := InventoryForProject(proj); len(inventory) > 0 {
+		// Use the most recent note actually on disk as the migration source,
+		// rather than assuming yesterday - a heuristic that loses "For
+		// tomorrow" content across weekends or any day goday wasn't run.
+		yesterdayPath = inventory[0].Path
+	} else if len(proj.LastFileCreated) > 0 {
+		yesterdayPath = proj.LastFileCreated
 	}
 
-	todayPath := filepath.Join(projectPath, todayFilename)
+	pending, err := createDailyNote(todayPath, yesterdayPath, m)
+	if err != nil {
+		appLogger.Printf("failed to create daily note %s: %v", todayPath, err)
+		return DaemonReadyMsg{}
+	}
+	if pending != nil {
+		pending.PreviousPath = m.projects[m.cursor].PreviousFileCreated
+		return RemigrateConfirmMsg{Request: pending}
+	}
 
-	createDailyNote(todayPath, yesterdayPath, m)
 	//This has 1 fault, if the list positions change
-	emacsHasOpened := openEmacs(m.projects[m.cursor].LastFileCreated, m.projects[m.cursor].PreviousFileCreated)
+	finishDailyWorkflow(m.projects[m.cursor].LastFileCreated, m.projects[m.cursor].PreviousFileCreated)
+	return DaemonReadyMsg{}
+}
+
+// finishDailyWorkflow opens emacs on today's (and the previous) note,
+// retrying a few times while the daemon is still spinning up.
+func finishDailyWorkflow(currentFilePath, previousFilePath string) {
+	emacsHasOpened := openEmacs(currentFilePath, previousFilePath)
 	for i := 0; !emacsHasOpened && i < 4; i++ {
 		time.Sleep(time.Second * DaemonOpenRetryTime)
-		emacsHasOpened = openEmacs(m.projects[m.cursor].LastFileCreated, m.projects[m.cursor].PreviousFileCreated)
+		emacsHasOpened = openEmacs(currentFilePath, previousFilePath)
 	}
 }
 
-// Could return true if new file was created -> then saveConfig only when was created but need to update LastOpened anyway so left it for now
-func createDailyNote(todayFile, yesterdayFile string, m *model) {
+// RemigrateRequest describes a stale "For tomorrow" section that the user
+// needs to confirm pulling into an already-generated daily note.
+type RemigrateRequest struct {
+	TodayPath     string
+	YesterdayPath string
+	PreviousPath  string
+	NewItems      []string
+}
+
+// createDailyNote creates todayFile if it doesn't exist yet, migrating
+// SourceHeader from yesterdayFile underneath DestinationHeader. If todayFile
+// already exists but yesterdayFile's SourceHeader section was edited after
+// todayFile was generated, it returns a RemigrateRequest instead of writing
+// anything, so the caller can ask the user before touching the file.
+func createDailyNote(todayFile, yesterdayFile string, m *model) (*RemigrateRequest, error) {
+	var sourceMtime time.Time
+	if info, err := os.Stat(yesterdayFile); err == nil {
+		sourceMtime = info.ModTime()
+	}
+
+	proj := &m.projects[m.cursor]
+
+	if todayInfo, err := os.Stat(todayFile); err == nil {
+		// Already generated today - only worth bothering the user if the
+		// source section changed since we last migrated it AND since we
+		// generated this file (so re-running twice the same day is a no-op).
+		stale := !sourceMtime.IsZero() && sourceMtime.After(proj.SourceMtime) && sourceMtime.After(todayInfo.ModTime())
+		if !stale {
+			return nil, nil
+		}
+		already := make(map[string]bool)
+		for _, l := range extractSection(todayFile, DestinationHeader) {
+			already[strings.TrimSpace(l)] = true
+		}
+		var newItems []string
+		for _, l := range extractSection(yesterdayFile, SourceHeader) {
+			if trimmed := strings.TrimSpace(l); trimmed != "" && !already[trimmed] {
+				newItems = append(newItems, l)
+			}
+		}
+		if len(newItems) == 0 {
+			return nil, nil
+		}
+		return &RemigrateRequest{TodayPath: todayFile, YesterdayPath: yesterdayFile, NewItems: newItems}, nil
+	}
+
 	var contentToMigrate []string
-	if _, err := os.Stat(yesterdayFile); err == nil {
+	if !sourceMtime.IsZero() {
 		contentToMigrate = extractSection(yesterdayFile, SourceHeader)
 	}
 
-	tdFile, err := os.Stat(todayFile)
-	if err == nil && tdFile != nil {
-		//Here we will do things on an already created file, for now nothing
-		return
-	}
 	f, err := os.Create(todayFile)
 	if err != nil {
-		return
+		return nil, err
 	}
 
 	writer := bufio.NewWriter(f)
@@ -452,9 +722,97 @@ func createDailyNote(todayFile, yesterdayFile string, m *model) {
 	//The mini problem with defers in go is that you can't defer assignments
 	//And it makes it so that the code is not read from Top to Bottom
 	f.Close()
-	m.projects[m.cursor].PreviousFileCreated = m.projects[m.cursor].LastFileCreated
-	m.projects[m.cursor].LastFileCreated = todayFile
+	proj.PreviousFileCreated = proj.LastFileCreated
+	proj.LastFileCreated = todayFile
+	proj.SourceFile = yesterdayFile
+	proj.SourceMtime = sourceMtime
+	m.notes.SetProjects(m.projects)
 	saveConfig(m.projects)
+	return nil, nil
+}
+
+// applyRemigration pulls req.NewItems into DestinationHeader of req.TodayPath,
+// stamps the file's mtime so the freshness check in createDailyNote is
+// idempotent for the rest of the day, and records the new SourceMtime.
+func applyRemigration(req *RemigrateRequest, m *model) {
+	if err := insertUnderHeader(req.TodayPath, DestinationHeader, req.NewItems); err != nil {
+		appLogger.Printf("remigration failed for %s: %v", req.TodayPath, err)
+		return
+	}
+	now := time.Now()
+	if err := os.Chtimes(req.TodayPath, now, now); err != nil {
+		appLogger.Printf("failed to stamp mtime on %s: %v", req.TodayPath, err)
+	}
+	if info, err := os.Stat(req.YesterdayPath); err == nil {
+		m.projects[m.cursor].SourceMtime = info.ModTime()
+	}
+	m.notes.SetProjects(m.projects)
+	saveConfig(m.projects)
+}
+
+// declineRemigration records that the user chose to skip a remigration, by
+// bumping SourceMtime to the section's current mtime, so the same prompt
+// doesn't reappear on every subsequent open of the project for the rest of
+// the day.
+func declineRemigration(req *RemigrateRequest, m *model) {
+	if info, err := os.Stat(req.YesterdayPath); err == nil {
+		m.projects[m.cursor].SourceMtime = info.ModTime()
+	}
+	m.notes.SetProjects(m.projects)
+	saveConfig(m.projects)
+}
+
+// insertUnderHeader appends newLines under the first occurrence of header in
+// path (before the next "* " header or EOF), skipping lines that are already
+// present in that section so already-completed TODOs are never duplicated
+// or clobbered.
+func insertUnderHeader(path, header string, newLines []string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(data), "\n")
+
+	headerIdx := -1
+	for i, l := range lines {
+		if strings.TrimSpace(l) == header {
+			headerIdx = i
+			break
+		}
+	}
+	if headerIdx == -1 {
+		return fmt.Errorf("header %q not found in %s", header, path)
+	}
+
+	sectionEnd := len(lines)
+	for i := headerIdx + 1; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], "* ") {
+			sectionEnd = i
+			break
+		}
+	}
+
+	existing := make(map[string]bool, sectionEnd-headerIdx)
+	for _, l := range lines[headerIdx+1 : sectionEnd] {
+		existing[strings.TrimSpace(l)] = true
+	}
+
+	var toAdd []string
+	for _, l := range newLines {
+		if trimmed := strings.TrimSpace(l); trimmed != "" && !existing[trimmed] {
+			toAdd = append(toAdd, l)
+		}
+	}
+	if len(toAdd) == 0 {
+		return nil
+	}
+
+	out := make([]string, 0, len(lines)+len(toAdd))
+	out = append(out, lines[:sectionEnd]...)
+	out = append(out, toAdd...)
+	out = append(out, lines[sectionEnd:]...)
+
+	return os.WriteFile(path, []byte(strings.Join(out, "\n")), 0644)
 }
 
 func extractSection(filename, targetHeader string) []string {
@@ -511,11 +869,6 @@ func openEmacs(currentFilePath, previousFilePath string) bool {
 // Tried reading console output from cmd.Stdout by passing a buffer but failed at it
 // For now I'll just have a set amount of time to wait for daemon to run
 func runEmacsDaemon(returnChan chan<- string) {
-	file, err := os.Create("C:\\Users\\Ramand\\Desktop\\goTerminal\\firstApp\\output.log")
-	if err != nil {
-		panic(err)
-	}
-	defer file.Close()
 	cmd := exec.Command("emacs", "--daemon")
 	stderr, _ := cmd.StderrPipe()
 
@@ -524,6 +877,7 @@ func runEmacsDaemon(returnChan chan<- string) {
 	scanner := bufio.NewScanner(stderr)
 	for scanner.Scan() {
 		line := scanner.Text()
+		appLogger.Println(line)
 
 		if strings.Contains(line, DoomLoadedMsg) {
 			returnChan <- SuccessMsg
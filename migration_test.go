@@ -0,0 +1,105 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtractSection(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		header string
+		want   []string
+	}{
+		{
+			name: "captures lines until next header",
+			body: "#+TITLE: 2026-07-25\n" +
+				"* TODO\n" +
+				"done stuff\n" +
+				"* For tomorrow\n" +
+				"buy milk\n" +
+				"write report\n" +
+				"* Notes\n" +
+				"unrelated\n",
+			header: "* For tomorrow",
+			want:   []string{"buy milk", "write report"},
+		},
+		{
+			name:   "captures to EOF when there is no following header",
+			body:   "* For tomorrow\nlast thing\n",
+			header: "* For tomorrow",
+			want:   []string{"last thing"},
+		},
+		{
+			name:   "header not present returns nil",
+			body:   "* TODO\nsomething\n",
+			header: "* For tomorrow",
+			want:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "note.org")
+			if err := os.WriteFile(path, []byte(tc.body), 0644); err != nil {
+				t.Fatalf("setup: %v", err)
+			}
+			got := extractSection(path, tc.header)
+			if !equalStrings(got, tc.want) {
+				t.Errorf("extractSection() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExtractSectionMissingFile(t *testing.T) {
+	if got := extractSection(filepath.Join(t.TempDir(), "missing.org"), "* For tomorrow"); got != nil {
+		t.Errorf("extractSection() on missing file = %v, want nil", got)
+	}
+}
+
+func TestInsertUnderHeader(t *testing.T) {
+	body := "#+TITLE: 2026-07-26\n" +
+		"* TODO\n" +
+		"buy milk\n" +
+		"* For tomorrow\n"
+
+	path := filepath.Join(t.TempDir(), "note.org")
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	if err := insertUnderHeader(path, "* TODO", []string{"buy milk", "write report"}); err != nil {
+		t.Fatalf("insertUnderHeader: %v", err)
+	}
+
+	got := extractSection(path, "* TODO")
+	want := []string{"buy milk", "write report"}
+	if !equalStrings(got, want) {
+		t.Errorf("section after insert = %v, want %v (dedup against existing line failed)", got, want)
+	}
+}
+
+func TestInsertUnderHeaderMissingHeader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "note.org")
+	if err := os.WriteFile(path, []byte("* TODO\n"), 0644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := insertUnderHeader(path, "* For tomorrow", []string{"x"}); err == nil {
+		t.Error("insertUnderHeader with a header not present in the file should error, got nil")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}